@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsBackupSelection() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsBackupSelectionRead,
+
+		Schema: map[string]*schema.Schema{
+			"plan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"selection_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"iam_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tag": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Set: resourceAwsConditionTagHash,
+			},
+		},
+	}
+}
+
+func dataSourceAwsBackupSelectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+
+	planID := d.Get("plan_id").(string)
+	name := d.Get("name").(string)
+	selectionID := d.Get("selection_id").(string)
+
+	if name == "" && selectionID == "" {
+		return fmt.Errorf("one of name or selection_id must be set")
+	}
+
+	if selectionID == "" {
+		input := &backup.ListBackupSelectionsInput{
+			BackupPlanId: aws.String(planID),
+		}
+
+		err := conn.ListBackupSelectionsPages(input, func(page *backup.ListBackupSelectionsOutput, lastPage bool) bool {
+			for _, selection := range page.BackupSelectionsList {
+				if aws.StringValue(selection.SelectionName) == name {
+					selectionID = aws.StringValue(selection.SelectionId)
+					return false
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return fmt.Errorf("error listing Backup Selections: %s", err)
+		}
+
+		if selectionID == "" {
+			return fmt.Errorf("no Backup Selection with name %q found in plan %q", name, planID)
+		}
+	}
+
+	getInput := &backup.GetBackupSelectionInput{
+		BackupPlanId: aws.String(planID),
+		SelectionId:  aws.String(selectionID),
+	}
+
+	resp, err := conn.GetBackupSelection(getInput)
+	if err != nil {
+		return fmt.Errorf("error reading Backup Selection: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.SelectionId))
+	d.Set("plan_id", resp.BackupPlanId)
+	d.Set("selection_id", resp.SelectionId)
+	d.Set("name", resp.BackupSelection.SelectionName)
+	d.Set("iam_role_arn", resp.BackupSelection.IamRoleArn)
+
+	if resp.BackupSelection.Resources != nil {
+		d.Set("resources", resp.BackupSelection.Resources)
+	}
+
+	if resp.BackupSelection.Conditions != nil {
+		if err := d.Set("tag", flattenBackupConditions(resp.BackupSelection.Conditions)); err != nil {
+			return fmt.Errorf("error setting tag: %s", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsBackupSelection_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_backup_selection.test"
+	dataSourceName := "data.aws_backup_selection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsBackupSelectionConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "plan_id", resourceName, "plan_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "selection_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "iam_role_arn", resourceName, "iam_role_arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsBackupSelectionConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_backup_vault" "test" {
+  name = "tf-testacc-backup-vault-%[1]d"
+}
+
+resource "aws_backup_plan" "test" {
+  name = "tf-testacc-backup-plan-%[1]d"
+
+  rule {
+    rule_name         = "tf-testacc-backup-rule-%[1]d"
+    target_vault_name = aws_backup_vault.test.name
+    schedule          = "cron(0 12 * * ? *)"
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = "tf-testacc-backup-role-%[1]d"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "backup.amazonaws.com"
+      }
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_backup_selection" "test" {
+  name         = "tf-testacc-backup-selection-%[1]d"
+  plan_id      = aws_backup_plan.test.id
+  iam_role_arn = aws_iam_role.test.arn
+
+  resources = [
+    "*",
+  ]
+}
+
+data "aws_backup_selection" "test" {
+  plan_id = aws_backup_selection.test.plan_id
+  name    = aws_backup_selection.test.name
+}
+`, rInt)
+}
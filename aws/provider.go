@@ -0,0 +1,18 @@
+package aws
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Provider returns a terraform.ResourceProvider.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_backup_selection": dataSourceAwsBackupSelection(),
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_backup_selection": resourceAwsBackupSelection(),
+		},
+	}
+}
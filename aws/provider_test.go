@@ -0,0 +1,25 @@
+package aws
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var testAccProviders map[string]terraform.ResourceProvider
+var testAccProvider *schema.Provider
+
+func init() {
+	testAccProvider = Provider()
+	testAccProviders = map[string]terraform.ResourceProvider{
+		"aws": testAccProvider,
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	if os.Getenv("AWS_DEFAULT_REGION") == "" {
+		t.Fatal("AWS_DEFAULT_REGION must be set for acceptance tests")
+	}
+}
@@ -5,19 +5,31 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// resourceAwsBackupSelection intentionally has no top-level "tags" argument.
+// Selections can't be tagged directly in AWS Backup, and tagging the parent
+// plan from a selection would let sibling selections on the same plan
+// clobber each other's tags and leave selections with no tags configured
+// perpetually diffing against whatever another selection last set. Tag the
+// aws_backup_plan resource itself instead.
 func resourceAwsBackupSelection() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsBackupSelectionCreate,
 		Read:   resourceAwsBackupSelectionRead,
+		Update: resourceAwsBackupSelectionUpdate,
 		Delete: resourceAwsBackupSelectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsBackupSelectionImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -37,13 +49,11 @@ func resourceAwsBackupSelection() *schema.Resource {
 			"iam_role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validateArn,
 			},
 			"tag": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
@@ -51,6 +61,9 @@ func resourceAwsBackupSelection() *schema.Resource {
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
 								backup.ConditionTypeStringequals,
+								backup.ConditionTypeStringnotequals,
+								backup.ConditionTypeStringlike,
+								backup.ConditionTypeStringnotlike,
 							}, false),
 						},
 						"key": {
@@ -68,9 +81,12 @@ func resourceAwsBackupSelection() *schema.Resource {
 			"resources": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -80,7 +96,7 @@ func resourceAwsBackupSelectionCreate(d *schema.ResourceData, meta interface{})
 
 	selection := &backup.Selection{
 		IamRoleArn:    aws.String(d.Get("iam_role_arn").(string)),
-		ListOfTags:    expandBackupConditionTags(d.Get("tag").(*schema.Set).List()),
+		Conditions:    expandBackupConditions(d.Get("tag").(*schema.Set).List()),
 		Resources:     expandStringList(d.Get("resources").([]interface{})),
 		SelectionName: aws.String(d.Get("name").(string)),
 	}
@@ -100,6 +116,61 @@ func resourceAwsBackupSelectionCreate(d *schema.ResourceData, meta interface{})
 	return resourceAwsBackupSelectionRead(d, meta)
 }
 
+// resourceAwsBackupSelectionUpdate handles changes to tag, resources, and
+// iam_role_arn. AWS Backup has no UpdateBackupSelection API, so those fields
+// are updated by deleting the old selection and creating a new one, with the
+// resource ID replaced to match the new selection.
+func resourceAwsBackupSelectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+
+	planID := d.Get("plan_id").(string)
+
+	deleteInput := &backup.DeleteBackupSelectionInput{
+		BackupPlanId: aws.String(planID),
+		SelectionId:  aws.String(d.Id()),
+	}
+
+	if _, err := conn.DeleteBackupSelection(deleteInput); err != nil {
+		return fmt.Errorf("error deleting Backup Selection: %s", err)
+	}
+
+	selection := &backup.Selection{
+		IamRoleArn:    aws.String(d.Get("iam_role_arn").(string)),
+		Conditions:    expandBackupConditions(d.Get("tag").(*schema.Set).List()),
+		Resources:     expandStringList(d.Get("resources").([]interface{})),
+		SelectionName: aws.String(d.Get("name").(string)),
+	}
+
+	createInput := &backup.CreateBackupSelectionInput{
+		BackupPlanId:    aws.String(planID),
+		BackupSelection: selection,
+	}
+
+	resp, err := conn.CreateBackupSelection(createInput)
+	if err != nil {
+		// The old selection is already gone; don't leave the state pointing at
+		// a deleted ID.
+		d.SetId("")
+		return fmt.Errorf("error creating Backup Selection: %s", err)
+	}
+
+	d.SetId(*resp.SelectionId)
+
+	return resourceAwsBackupSelectionRead(d, meta)
+}
+
+func resourceAwsBackupSelectionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of import ID (%q), expected plan_id/selection_id", d.Id())
+	}
+
+	d.Set("plan_id", idParts[0])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsBackupSelectionRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).backupconn
 
@@ -119,24 +190,15 @@ func resourceAwsBackupSelectionRead(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("error reading Backup Selection: %s", err)
 	}
 
-	d.Set("plan_id", resp.BackupPlanId)
-	d.Set("name", resp.BackupSelection.SelectionName)
-	d.Set("iam_role", resp.BackupSelection.IamRoleArn)
-
-	if resp.BackupSelection.ListOfTags != nil {
-		tag := &schema.Set{F: resourceAwsConditionTagHash}
+	planID := aws.StringValue(resp.BackupPlanId)
 
-		for _, r := range resp.BackupSelection.ListOfTags {
-			m := make(map[string]interface{})
-
-			m["type"] = aws.StringValue(r.ConditionType)
-			m["key"] = aws.StringValue(r.ConditionKey)
-			m["value"] = aws.StringValue(r.ConditionValue)
-
-			tag.Add(m)
-		}
+	d.Set("plan_id", planID)
+	d.Set("name", resp.BackupSelection.SelectionName)
+	d.Set("iam_role_arn", resp.BackupSelection.IamRoleArn)
+	d.Set("arn", backupSelectionArn(meta, planID, d.Id()))
 
-		if err := d.Set("tag", tag); err != nil {
+	if resp.BackupSelection.Conditions != nil {
+		if err := d.Set("tag", flattenBackupConditions(resp.BackupSelection.Conditions)); err != nil {
 			return fmt.Errorf("error setting tag: %s", err)
 		}
 	}
@@ -163,23 +225,68 @@ func resourceAwsBackupSelectionDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func expandBackupConditionTags(tagList []interface{}) []*backup.Condition {
-	conditions := []*backup.Condition{}
+func backupSelectionArn(meta interface{}, planID, selectionID string) string {
+	client := meta.(*AWSClient)
+
+	return arn.ARN{
+		Partition: client.partition,
+		Service:   "backup",
+		Region:    client.region,
+		AccountID: client.accountid,
+		Resource:  fmt.Sprintf("backup-plan:%s/selection:%s", planID, selectionID),
+	}.String()
+}
+
+// expandBackupConditions buckets the "tag" set by operator into a
+// backup.Conditions, since the Backup API groups StringEquals,
+// StringNotEquals, StringLike, and StringNotLike into separate lists rather
+// than tagging each condition with its operator.
+func expandBackupConditions(tagList []interface{}) *backup.Conditions {
+	conditions := &backup.Conditions{}
 
 	for _, i := range tagList {
 		item := i.(map[string]interface{})
-		tag := &backup.Condition{}
-
-		tag.ConditionType = aws.String(item["type"].(string))
-		tag.ConditionKey = aws.String(item["key"].(string))
-		tag.ConditionValue = aws.String(item["value"].(string))
+		param := &backup.ConditionParameter{
+			ConditionKey:   aws.String(item["key"].(string)),
+			ConditionValue: aws.String(item["value"].(string)),
+		}
 
-		conditions = append(conditions, tag)
+		switch item["type"].(string) {
+		case backup.ConditionTypeStringequals:
+			conditions.StringEquals = append(conditions.StringEquals, param)
+		case backup.ConditionTypeStringnotequals:
+			conditions.StringNotEquals = append(conditions.StringNotEquals, param)
+		case backup.ConditionTypeStringlike:
+			conditions.StringLike = append(conditions.StringLike, param)
+		case backup.ConditionTypeStringnotlike:
+			conditions.StringNotLike = append(conditions.StringNotLike, param)
+		}
 	}
 
 	return conditions
 }
 
+func flattenBackupConditions(conditions *backup.Conditions) *schema.Set {
+	tag := &schema.Set{F: resourceAwsConditionTagHash}
+
+	flattenConditionParams := func(conditionType string, params []*backup.ConditionParameter) {
+		for _, p := range params {
+			tag.Add(map[string]interface{}{
+				"type":  conditionType,
+				"key":   aws.StringValue(p.ConditionKey),
+				"value": aws.StringValue(p.ConditionValue),
+			})
+		}
+	}
+
+	flattenConditionParams(backup.ConditionTypeStringequals, conditions.StringEquals)
+	flattenConditionParams(backup.ConditionTypeStringnotequals, conditions.StringNotEquals)
+	flattenConditionParams(backup.ConditionTypeStringlike, conditions.StringLike)
+	flattenConditionParams(backup.ConditionTypeStringnotlike, conditions.StringNotLike)
+
+	return tag
+}
+
 func resourceAwsConditionTagHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})